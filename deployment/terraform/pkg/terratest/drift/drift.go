@@ -0,0 +1,130 @@
+// Package drift detects configuration drift in an already-deployed
+// Terraform environment by running `terraform plan` and inspecting the
+// resulting plan JSON for unexpected resource changes.
+package drift
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/gruntwork-io/terratest/modules/testing"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// watchedActions are the plan actions that indicate drift; a plain "create"
+// for a resource that doesn't exist yet (first run) or "no-op" are not
+// considered drift.
+var watchedActions = map[tfjson.Action]bool{
+	tfjson.ActionUpdate:  true,
+	tfjson.ActionDelete:  true,
+	tfjson.ActionCreate:  false,
+	tfjson.ActionNoop:    false,
+	tfjson.ActionRead:    false,
+}
+
+// ResourceDrift describes a single resource whose planned change indicates
+// drift from the last applied state.
+type ResourceDrift struct {
+	Address string
+	Type    string
+	Actions []tfjson.Action
+	Before  map[string]interface{}
+	After   map[string]interface{}
+}
+
+// Report is the result of a single drift check against one environment.
+type Report struct {
+	TerraformDir string
+	Resources    []ResourceDrift
+}
+
+// HasDrift reports whether any watched resource changed.
+func (r *Report) HasDrift() bool {
+	return len(r.Resources) > 0
+}
+
+// Markdown renders the report as a Markdown document suitable for posting
+// as a PR or chat comment.
+func (r *Report) Markdown() string {
+	var b strings.Builder
+
+	if !r.HasDrift() {
+		fmt.Fprintf(&b, "### Drift report: %s\n\nNo drift detected.\n", r.TerraformDir)
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "### Drift report: %s\n\n", r.TerraformDir)
+	fmt.Fprintf(&b, "%d resource(s) have drifted from the last applied state:\n\n", len(r.Resources))
+
+	for _, res := range r.Resources {
+		fmt.Fprintf(&b, "#### `%s` (%s)\n\n", res.Address, actionSummary(res.Actions))
+		fmt.Fprintf(&b, "| attribute | before | after |\n|---|---|---|\n")
+		for attr, before := range res.Before {
+			after := res.After[attr]
+			if fmt.Sprint(before) == fmt.Sprint(after) {
+				continue
+			}
+			fmt.Fprintf(&b, "| `%s` | `%v` | `%v` |\n", attr, before, after)
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+func actionSummary(actions []tfjson.Action) string {
+	parts := make([]string, len(actions))
+	for i, a := range actions {
+		parts[i] = string(a)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Detect runs `terraform init`, `terraform plan` and `terraform show -json`,
+// parses the resulting plan JSON, and returns a Report limited to resources
+// whose type appears in allowedTypes and whose planned action is update,
+// delete, or replace (replace surfaces as both delete and create actions on
+// the same change). Drift is judged from the plan JSON alone: the plan is
+// not run with -detailed-exitcode and its exit code is not inspected.
+func Detect(t testing.TestingT, options *terraform.Options, allowedTypes []string) (*Report, error) {
+	allowed := map[string]bool{}
+	for _, typ := range allowedTypes {
+		allowed[typ] = true
+	}
+
+	plan, err := terraform.InitAndPlanAndShowWithStructE(t, options)
+	if err != nil {
+		return nil, fmt.Errorf("running terraform plan: %w", err)
+	}
+
+	report := &Report{TerraformDir: options.TerraformDir}
+	for _, change := range plan.RawPlan.ResourceChanges {
+		if !allowed[change.Type] {
+			continue
+		}
+
+		var drifted []tfjson.Action
+		for _, action := range change.Change.Actions {
+			if watchedActions[action] {
+				drifted = append(drifted, action)
+			}
+		}
+		if len(drifted) == 0 {
+			continue
+		}
+
+		before, _ := change.Change.Before.(map[string]interface{})
+		after, _ := change.Change.After.(map[string]interface{})
+
+		report.Resources = append(report.Resources, ResourceDrift{
+			Address: change.Address,
+			Type:    change.Type,
+			Actions: drifted,
+			Before:  before,
+			After:   after,
+		})
+	}
+
+	return report, nil
+}
@@ -0,0 +1,58 @@
+// Package ibmcloud provides small helpers terratest-based tests use to talk
+// directly to IBM Cloud, outside of Terraform, for things Terraform itself
+// can't confirm (e.g. that a "destroyed" resource is actually gone).
+package ibmcloud
+
+import (
+	"fmt"
+
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// VerifyResourcesDeleted confirms, via the Resource Controller API rather
+// than Terraform state, that none of the given resource instance names
+// still exist in the resource group. This guards against the common
+// terratest gotcha where `terraform destroy` reports success but IBM Cloud
+// leaves an orphaned instance behind (most often databases and Event
+// Streams, which delete asynchronously).
+func VerifyResourcesDeleted(apiKey, resourceGroupID string, instanceNames []string) error {
+	authenticator := &core.IamAuthenticator{ApiKey: apiKey}
+	client, err := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+		Authenticator: authenticator,
+	})
+	if err != nil {
+		return fmt.Errorf("creating resource controller client: %w", err)
+	}
+
+	wanted := map[string]bool{}
+	for _, name := range instanceNames {
+		wanted[name] = true
+	}
+
+	options := client.NewListResourceInstancesOptions()
+	options.SetResourceGroupID(resourceGroupID)
+
+	result, _, err := client.ListResourceInstances(options)
+	if err != nil {
+		return fmt.Errorf("listing resource instances: %w", err)
+	}
+
+	var orphaned []string
+	for _, instance := range result.Resources {
+		if instance.Name == nil || instance.State == nil {
+			continue
+		}
+		if !wanted[*instance.Name] {
+			continue
+		}
+		if *instance.State != "removed" && *instance.State != "pending_reclamation" {
+			orphaned = append(orphaned, *instance.Name)
+		}
+	}
+
+	if len(orphaned) > 0 {
+		return fmt.Errorf("resources still present after destroy: %v", orphaned)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package contract
+
+// AWSFixture plans modules/aws/managed-services and modules/aws/compute
+// (RDS + S3 + MSK for managed-services, ECS/Fargate for compute).
+type AWSFixture struct{}
+
+func (AWSFixture) Name() string { return "aws" }
+
+func (AWSFixture) ManagedServicesDir() string { return "../../modules/aws/managed-services" }
+
+func (AWSFixture) ComputeDir() string { return "../../modules/aws/compute" }
+
+func (AWSFixture) ManagedServicesVars() map[string]interface{} {
+	return map[string]interface{}{
+		"project_name":              "contract-test",
+		"environment":               "dev",
+		"region":                    "us-east-1",
+		"postgresql_admin_password": "test-password-123",
+	}
+}
+
+// ComputeVars wires the ECS/Fargate module's managed-service-dependent vars
+// from the outputs planned by managed-services, falling back to a stub only
+// for values managed-services doesn't plan as an output (credentials, ids
+// the provider assigns at apply time).
+func (AWSFixture) ComputeVars(managedServicesOutputs map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"project_name":               "contract-test",
+		"environment":                "dev",
+		"container_registry_url":     "123456789012.dkr.ecr.us-east-1.amazonaws.com",
+		"backend_image_tag":          "v1.0.0",
+		"frontend_image_tag":         "v1.0.0",
+		"postgresql_host":            outputOrStub(managedServicesOutputs, "rds_endpoint", "test-rds.example.com"),
+		"postgresql_port":            5432,
+		"postgresql_database":        "test_db",
+		"postgresql_username":        "test_user",
+		"postgresql_password":        "test_password",
+		"object_storage_endpoint":    outputOrStub(managedServicesOutputs, "s3_bucket_endpoint", "test-bucket.s3.amazonaws.com"),
+		"object_storage_bucket_name": "test-bucket",
+		"vector_db_endpoint":         outputOrStub(managedServicesOutputs, "opensearch_endpoint", "test-opensearch.example.com"),
+		"event_streams_endpoint":     outputOrStub(managedServicesOutputs, "msk_bootstrap_brokers", "test-msk.example.com"),
+	}
+}
+
+func (AWSFixture) OutputAliases() map[string]string {
+	return map[string]string{
+		"postgresql_host":         "rds_endpoint",
+		"object_storage_endpoint": "s3_bucket_endpoint",
+		"vector_db_endpoint":      "opensearch_endpoint",
+		"event_streams_endpoint":  "msk_bootstrap_brokers",
+	}
+}
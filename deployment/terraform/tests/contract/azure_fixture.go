@@ -0,0 +1,54 @@
+package contract
+
+// AzureFixture plans modules/azure/managed-services and
+// modules/azure/compute (Azure Database for PostgreSQL + Blob Storage +
+// Event Hubs for managed-services, Azure Container Apps for compute).
+type AzureFixture struct{}
+
+func (AzureFixture) Name() string { return "azure" }
+
+func (AzureFixture) ManagedServicesDir() string { return "../../modules/azure/managed-services" }
+
+func (AzureFixture) ComputeDir() string { return "../../modules/azure/compute" }
+
+func (AzureFixture) ManagedServicesVars() map[string]interface{} {
+	return map[string]interface{}{
+		"project_name":              "contract-test",
+		"environment":               "dev",
+		"region":                    "eastus",
+		"resource_group_name":       "test-resource-group",
+		"postgresql_admin_password": "test-password-123",
+	}
+}
+
+// ComputeVars wires the Container Apps module's managed-service-dependent
+// vars from the outputs planned by managed-services, falling back to a
+// stub only for values managed-services doesn't plan as an output
+// (credentials, ids the provider assigns at apply time).
+func (AzureFixture) ComputeVars(managedServicesOutputs map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"project_name":            "contract-test",
+		"environment":             "dev",
+		"resource_group_name":     "test-resource-group",
+		"container_registry_url":  "testregistry.azurecr.io",
+		"backend_image_tag":       "v1.0.0",
+		"frontend_image_tag":      "v1.0.0",
+		"postgresql_host":         outputOrStub(managedServicesOutputs, "postgresql_flexible_server_fqdn", "test-postgres.postgres.database.azure.com"),
+		"postgresql_port":         5432,
+		"postgresql_database":     "test_db",
+		"postgresql_username":     "test_user",
+		"postgresql_password":     "test_password",
+		"object_storage_endpoint": outputOrStub(managedServicesOutputs, "blob_storage_endpoint", "teststorage.blob.core.windows.net"),
+		"vector_db_endpoint":      outputOrStub(managedServicesOutputs, "ai_search_endpoint", "test-search.search.windows.net"),
+		"event_streams_endpoint":  outputOrStub(managedServicesOutputs, "event_hub_endpoint", "test-eventhub.servicebus.windows.net"),
+	}
+}
+
+func (AzureFixture) OutputAliases() map[string]string {
+	return map[string]string{
+		"postgresql_host":         "postgresql_flexible_server_fqdn",
+		"object_storage_endpoint": "blob_storage_endpoint",
+		"vector_db_endpoint":      "ai_search_endpoint",
+		"event_streams_endpoint":  "event_hub_endpoint",
+	}
+}
@@ -0,0 +1,74 @@
+package contract
+
+// IBMCloudFixture plans modules/ibm-cloud/managed-services and
+// modules/ibm-cloud/code-engine.
+type IBMCloudFixture struct{}
+
+func (IBMCloudFixture) Name() string { return "ibm-cloud" }
+
+func (IBMCloudFixture) ManagedServicesDir() string { return "../../modules/ibm-cloud/managed-services" }
+
+func (IBMCloudFixture) ComputeDir() string { return "../../modules/ibm-cloud/code-engine" }
+
+func (IBMCloudFixture) ManagedServicesVars() map[string]interface{} {
+	return map[string]interface{}{
+		"project_name":              "contract-test",
+		"environment":               "dev",
+		"region":                    "us-south",
+		"resource_group_id":         "test-resource-group",
+		"postgresql_admin_password": "test-password-123",
+	}
+}
+
+// ComputeVars wires code-engine's managed-service-dependent vars from the
+// outputs planned by managed-services, falling back to a stub only for
+// values managed-services doesn't plan as an output (credentials, ids the
+// provider assigns at apply time).
+func (IBMCloudFixture) ComputeVars(managedServicesOutputs map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"project_name":                "contract-test",
+		"environment":                 "dev",
+		"resource_group_id":           "test-resource-group",
+		"container_registry_url":      "us.icr.io",
+		"container_registry_username": "iamapikey",
+		"container_registry_password": "test-password",
+		"backend_image_tag":           "v1.0.0",
+		"frontend_image_tag":          "v1.0.0",
+		"postgresql_host":             outputOrStub(managedServicesOutputs, "postgresql_host", "test-postgres.example.com"),
+		"postgresql_port":             5432,
+		"postgresql_database":         "test_db",
+		"postgresql_username":         "test_user",
+		"postgresql_password":         "test_password",
+		"postgresql_instance_id":      "test-postgres-instance",
+		"object_storage_endpoint":     outputOrStub(managedServicesOutputs, "object_storage_endpoint", "test-storage.example.com"),
+		"object_storage_access_key":   "test_access_key",
+		"object_storage_secret_key":   "test_secret_key",
+		"object_storage_bucket_name":  "test-bucket",
+		"object_storage_instance_id":  "test-storage-instance",
+		"zilliz_endpoint":             outputOrStub(managedServicesOutputs, "zilliz_endpoint", "test-zilliz.example.com"),
+		"zilliz_api_key":              "test_zilliz_key",
+		"zilliz_instance_id":          "test-zilliz-instance",
+		"event_streams_endpoint":      outputOrStub(managedServicesOutputs, "event_streams_endpoint", "test-kafka.example.com"),
+		"event_streams_api_key":       "test_kafka_key",
+		"event_streams_instance_id":   "test-kafka-instance",
+	}
+}
+
+func (IBMCloudFixture) OutputAliases() map[string]string {
+	return map[string]string{
+		"vector_db_endpoint": "zilliz_endpoint",
+	}
+}
+
+// outputOrStub returns outputs[name] if present and non-empty, else stub.
+// managed-services is planned (not applied), so most of its outputs are
+// unknown-until-apply; when that's the case this falls back to the stub so
+// code-engine still has a concrete value to plan with.
+func outputOrStub(outputs map[string]interface{}, name, stub string) interface{} {
+	if value, ok := outputs[name]; ok {
+		if s, ok := value.(string); ok && s != "" {
+			return s
+		}
+	}
+	return stub
+}
@@ -0,0 +1,65 @@
+// Package contract defines the provider-agnostic output contract that every
+// cloud's managed-services + compute modules must satisfy so the RAG stack
+// can be deployed on any of them interchangeably.
+package contract
+
+import "github.com/gruntwork-io/terratest/modules/terraform"
+
+// RequiredOutputs are the Terraform outputs every provider's
+// managed-services/code-engine-equivalent pairing must expose, regardless
+// of what they're backed by underneath (IBM Cloud Databases vs RDS vs Azure
+// Database for PostgreSQL, Code Engine vs ECS/Fargate vs Container Apps,
+// etc).
+var RequiredOutputs = []string{
+	"postgresql_host",
+	"object_storage_endpoint",
+	"vector_db_endpoint",
+	"event_streams_endpoint",
+	"backend_endpoint",
+	"frontend_endpoint",
+	"backend_health_endpoint",
+}
+
+// ProviderFixture is implemented once per cloud provider and supplies the
+// module paths and Terraform variables needed to plan that provider's
+// managed-services and compute modules.
+type ProviderFixture interface {
+	// Name identifies the provider, e.g. "ibm-cloud", "aws", "azure".
+	Name() string
+
+	// ManagedServicesDir is the Terraform directory for the provider's
+	// managed-services module (databases, object storage, vector DB,
+	// event streaming).
+	ManagedServicesDir() string
+
+	// ComputeDir is the Terraform directory for the provider's compute
+	// module (Code Engine, ECS/Fargate, Azure Container Apps).
+	ComputeDir() string
+
+	// ManagedServicesVars returns the Vars to plan ManagedServicesDir.
+	ManagedServicesVars() map[string]interface{}
+
+	// ComputeVars returns the Vars to plan ComputeDir, wired from the
+	// outputs planned from the managed-services module (keyed by the
+	// *provider's own* output names, not RequiredOutputs).
+	ComputeVars(managedServicesOutputs map[string]interface{}) map[string]interface{}
+
+	// OutputAliases maps a RequiredOutputs name to the name this
+	// provider's modules actually use for it, for any name that differs
+	// from the contract (e.g. IBM Cloud's managed-services module calls
+	// the vector DB output "zilliz_endpoint" rather than
+	// "vector_db_endpoint"). Names not present here are assumed to match
+	// RequiredOutputs exactly.
+	OutputAliases() map[string]string
+}
+
+// PlanOptions builds terraform.Options for dir/vars with no backend and no
+// credentials beyond what the fixture supplies; contract tests only plan,
+// they never apply.
+func PlanOptions(dir string, vars map[string]interface{}) *terraform.Options {
+	return &terraform.Options{
+		TerraformDir: dir,
+		Vars:         vars,
+		NoColor:      true,
+	}
+}
@@ -0,0 +1,63 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manavgup/rag_modulo/deployment/terraform/tests/contract"
+)
+
+// TestManagedServicesContract enforces that every supported cloud provider's
+// managed-services + compute module pairing exposes the same output shape
+// (contract.RequiredOutputs), so the RAG stack stays portable across
+// providers. Add a new provider by implementing contract.ProviderFixture and
+// appending it here.
+func TestManagedServicesContract(t *testing.T) {
+	t.Parallel()
+
+	fixtures := []contract.ProviderFixture{
+		contract.IBMCloudFixture{},
+		contract.AWSFixture{},
+		contract.AzureFixture{},
+	}
+
+	for _, fixture := range fixtures {
+		fixture := fixture
+		t.Run(fixture.Name(), func(t *testing.T) {
+			t.Parallel()
+
+			managedServicesOptions := contract.PlanOptions(fixture.ManagedServicesDir(), fixture.ManagedServicesVars())
+			managedServicesPlan := terraform.InitAndPlanAndShowWithStruct(t, managedServicesOptions)
+			require.NotNil(t, managedServicesPlan)
+
+			managedServicesOutputs := map[string]interface{}{}
+			for name, output := range managedServicesPlan.RawPlan.PlannedValues.Outputs {
+				managedServicesOutputs[name] = output.Value
+			}
+
+			computeOptions := contract.PlanOptions(fixture.ComputeDir(), fixture.ComputeVars(managedServicesOutputs))
+			computePlan := terraform.InitAndPlanAndShowWithStruct(t, computeOptions)
+			require.NotNil(t, computePlan)
+
+			allOutputs := map[string]interface{}{}
+			for name, output := range managedServicesPlan.RawPlan.PlannedValues.Outputs {
+				allOutputs[name] = output.Value
+			}
+			for name, output := range computePlan.RawPlan.PlannedValues.Outputs {
+				allOutputs[name] = output.Value
+			}
+
+			aliases := fixture.OutputAliases()
+			for _, required := range contract.RequiredOutputs {
+				name := required
+				if alias, ok := aliases[required]; ok {
+					name = alias
+				}
+				_, ok := allOutputs[name]
+				require.Truef(t, ok, "%s: missing required output %q (looked for %q)", fixture.Name(), required, name)
+			}
+		})
+	}
+}
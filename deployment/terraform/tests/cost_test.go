@@ -0,0 +1,102 @@
+package tests
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/random"
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTerraformCostEstimate runs each module's plan through Infracost and
+// fails if the plan's total monthly cost or any individual resource's
+// monthly cost exceeds the budgets configured in tests/costs/budgets.yaml.
+func TestTerraformCostEstimate(t *testing.T) {
+	t.Parallel()
+
+	costsDir, err := filepath.Abs("costs")
+	require.NoError(t, err)
+
+	randomName := random.UniqueId()
+
+	modules := []struct {
+		name        string
+		dir         string
+		vars        map[string]interface{}
+		environment string
+	}{
+		{
+			name: "managed-services",
+			dir:  "../modules/ibm-cloud/managed-services",
+			vars: map[string]interface{}{
+				"project_name":              "cost-" + randomName,
+				"environment":               "dev",
+				"region":                    "us-south",
+				"resource_group_id":         "test-resource-group",
+				"postgresql_admin_password": "test-password-123",
+			},
+			environment: "dev",
+		},
+		{
+			name: "code-engine",
+			dir:  "../modules/ibm-cloud/code-engine",
+			vars: map[string]interface{}{
+				"project_name":                "cost-" + randomName,
+				"environment":                 "dev",
+				"resource_group_id":           "test-resource-group",
+				"container_registry_url":      "us.icr.io",
+				"container_registry_username": "iamapikey",
+				"container_registry_password": "test-password",
+				"backend_image_tag":           "v1.0.0",
+				"frontend_image_tag":          "v1.0.0",
+				"postgresql_host":             "test-postgres.example.com",
+				"postgresql_port":             5432,
+				"postgresql_database":         "test_db",
+				"postgresql_username":         "test_user",
+				"postgresql_password":         "test_password",
+				"postgresql_instance_id":      "test-postgres-instance",
+				"object_storage_endpoint":     "test-storage.example.com",
+				"object_storage_access_key":   "test_access_key",
+				"object_storage_secret_key":   "test_secret_key",
+				"object_storage_bucket_name":  "test-bucket",
+				"object_storage_instance_id":  "test-storage-instance",
+				"zilliz_endpoint":             "test-zilliz.example.com",
+				"zilliz_api_key":              "test_zilliz_key",
+				"zilliz_instance_id":          "test-zilliz-instance",
+				"event_streams_endpoint":      "test-kafka.example.com",
+				"event_streams_api_key":       "test_kafka_key",
+				"event_streams_instance_id":   "test-kafka-instance",
+			},
+			environment: "dev",
+		},
+	}
+
+	for _, module := range modules {
+		module := module
+		t.Run(module.name, func(t *testing.T) {
+			t.Parallel()
+
+			terraformOptions := &terraform.Options{
+				TerraformDir: module.dir,
+				Vars:         module.vars,
+				PlanFilePath: filepath.Join(t.TempDir(), "plan.tfplan"),
+			}
+
+			terraform.InitAndPlan(t, terraformOptions)
+
+			planJSONPath := filepath.Join(t.TempDir(), "plan.json")
+			writePlanJSON(t, planJSONPath, terraform.Show(t, terraformOptions))
+
+			report, err := runInfracost(planJSONPath, costsDir, module.environment)
+			require.NoError(t, err)
+
+			t.Logf("top cost contributors for %s:", module.name)
+			for i, resource := range report.TopResources {
+				t.Logf("  %d. %s: $%s/mo", i+1, resource.Name, resource.MonthlyCost)
+			}
+
+			require.Falsef(t, report.OverBudget(), "cost budget exceeded for %s: %v", module.name, report.Violations)
+		})
+	}
+}
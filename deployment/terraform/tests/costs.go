@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// infracostResource is the subset of an Infracost breakdown resource entry
+// this package needs.
+type infracostResource struct {
+	Name        string `json:"name"`
+	MonthlyCost string `json:"monthlyCost"`
+}
+
+// infracostBreakdown is the subset of `infracost breakdown --format json`
+// output this package needs.
+type infracostBreakdown struct {
+	TotalMonthlyCost string `json:"totalMonthlyCost"`
+	Projects         []struct {
+		Breakdown struct {
+			Resources []infracostResource `json:"resources"`
+		} `json:"breakdown"`
+	} `json:"projects"`
+}
+
+// budgetConfig is the shape of tests/costs/budgets.yaml.
+type budgetConfig struct {
+	Environments map[string]struct {
+		MonthlyBudgetUSD      float64            `yaml:"monthly_budget_usd"`
+		PerResourceCeilingUSD map[string]float64 `yaml:"per_resource_ceiling_usd"`
+	} `yaml:"environments"`
+}
+
+// CostReport is the result of checking a plan's Infracost breakdown against
+// tests/costs/budgets.yaml for a given environment.
+type CostReport struct {
+	Environment     string
+	TotalMonthlyUSD float64
+	TopResources    []infracostResource
+	Violations      []string
+}
+
+// OverBudget reports whether the plan exceeded its environment's budget.
+func (r *CostReport) OverBudget() bool {
+	return len(r.Violations) > 0
+}
+
+func loadBudgets(costsDir string) (budgetConfig, error) {
+	raw, err := os.ReadFile(filepath.Join(costsDir, "budgets.yaml"))
+	if err != nil {
+		return budgetConfig{}, fmt.Errorf("reading budgets.yaml: %w", err)
+	}
+
+	var cfg budgetConfig
+	if err := yaml.Unmarshal(raw, &cfg); err != nil {
+		return budgetConfig{}, fmt.Errorf("parsing budgets.yaml: %w", err)
+	}
+	return cfg, nil
+}
+
+// runInfracost invokes `infracost breakdown` against a Terraform plan JSON
+// file and checks the result against the per-resource and total-monthly
+// budgets configured for environment in tests/costs/budgets.yaml.
+func runInfracost(planJSONPath, costsDir, environment string) (*CostReport, error) {
+	budgets, err := loadBudgets(costsDir)
+	if err != nil {
+		return nil, err
+	}
+	budget, ok := budgets.Environments[environment]
+	if !ok {
+		return nil, fmt.Errorf("no budget configured for environment %q", environment)
+	}
+
+	cmd := exec.Command("infracost", "breakdown", "--path", planJSONPath, "--format", "json")
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("running infracost breakdown: %w", err)
+	}
+
+	var breakdown infracostBreakdown
+	if err := json.Unmarshal(out, &breakdown); err != nil {
+		return nil, fmt.Errorf("parsing infracost output: %w", err)
+	}
+
+	report := &CostReport{Environment: environment}
+	report.TotalMonthlyUSD, _ = strconv.ParseFloat(breakdown.TotalMonthlyCost, 64)
+
+	if report.TotalMonthlyUSD > budget.MonthlyBudgetUSD {
+		report.Violations = append(report.Violations, fmt.Sprintf(
+			"total monthly cost $%.2f exceeds %s budget of $%.2f", report.TotalMonthlyUSD, environment, budget.MonthlyBudgetUSD))
+	}
+
+	var resources []infracostResource
+	for _, project := range breakdown.Projects {
+		resources = append(resources, project.Breakdown.Resources...)
+	}
+
+	for _, resource := range resources {
+		cost, _ := strconv.ParseFloat(resource.MonthlyCost, 64)
+		for resourceType, ceiling := range budget.PerResourceCeilingUSD {
+			if resourceTypeOf(resource.Name) != resourceType {
+				continue
+			}
+			if cost > ceiling {
+				report.Violations = append(report.Violations, fmt.Sprintf(
+					"%s monthly cost $%.2f exceeds per-resource ceiling of $%.2f for %s", resource.Name, cost, ceiling, resourceType))
+			}
+		}
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		ci, _ := strconv.ParseFloat(resources[i].MonthlyCost, 64)
+		cj, _ := strconv.ParseFloat(resources[j].MonthlyCost, 64)
+		return ci > cj
+	})
+	if len(resources) > 10 {
+		resources = resources[:10]
+	}
+	report.TopResources = resources
+
+	return report, nil
+}
+
+// resourceTypeOf extracts the Terraform resource type from an Infracost
+// resource name, e.g. "module.managed_services.ibm_database.postgresql"
+// -> "ibm_database".
+func resourceTypeOf(resourceName string) string {
+	parts := strings.Split(resourceName, ".")
+	if len(parts) < 2 {
+		return resourceName
+	}
+	return parts[len(parts)-2]
+}
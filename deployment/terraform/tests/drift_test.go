@@ -0,0 +1,43 @@
+package tests
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manavgup/rag_modulo/deployment/terraform/pkg/terratest/drift"
+)
+
+// TestTerraformDriftDetection checks a previously-applied environment for
+// drift from its last known state. It requires a real backend/state file
+// for ../environments/ibm (e.g. TF_VAR_ibmcloud_api_key plus whatever
+// backend config points at the deployed environment) and is meant to run
+// on a schedule rather than on every PR, so it's opt-in via DRIFT_CHECK.
+func TestTerraformDriftDetection(t *testing.T) {
+	if os.Getenv("DRIFT_CHECK") == "" {
+		t.Skip("set DRIFT_CHECK=1 to run drift detection against a live environment")
+	}
+
+	terraformOptions := &terraform.Options{
+		TerraformDir: "../environments/ibm",
+		EnvVars: map[string]string{
+			"TF_VAR_ibmcloud_api_key": os.Getenv("IBMCLOUD_API_KEY"),
+		},
+	}
+
+	allowedTypes := []string{
+		"ibm_code_engine_app",
+		"ibm_database",
+		"ibm_cos_bucket",
+		"ibm_event_streams_topic",
+	}
+
+	report, err := drift.Detect(t, terraformOptions, allowedTypes)
+	require.NoError(t, err)
+
+	t.Log(report.Markdown())
+
+	require.Falsef(t, report.HasDrift(), "drift detected in %s:\n%s", terraformOptions.TerraformDir, report.Markdown())
+}
@@ -0,0 +1,128 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+// policyResult mirrors the subset of `conftest test -o json` output this
+// package cares about: one entry per input file, each carrying its own
+// failures and warnings.
+type policyResult struct {
+	Filename  string            `json:"filename"`
+	Namespace string            `json:"namespace"`
+	Failures  []policyViolation `json:"failures"`
+	Warnings  []policyViolation `json:"warnings"`
+}
+
+type policyViolation struct {
+	Msg string `json:"msg"`
+}
+
+// policyConfig is the shape of tests/policies/config.yaml: a map from
+// environment name to its severity overrides.
+type policyConfig map[string]struct {
+	Advisory []string `yaml:"advisory"`
+}
+
+// PolicyReport aggregates conftest results for a single plan across all
+// Rego bundles in policyDir, downgrading any policy named as advisory for
+// the given environment from a blocking failure to a warning.
+type PolicyReport struct {
+	Environment string
+	Blocking    []string
+	Advisory    []string
+}
+
+// Failed reports whether the report contains any blocking violation.
+func (r *PolicyReport) Failed() bool {
+	return len(r.Blocking) > 0
+}
+
+// String renders the report as a human-readable summary suitable for
+// t.Log or inclusion in a test failure message.
+func (r *PolicyReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "policy compliance report (environment=%s)\n", r.Environment)
+	fmt.Fprintf(&b, "  blocking: %d, advisory: %d\n", len(r.Blocking), len(r.Advisory))
+	for _, msg := range r.Blocking {
+		fmt.Fprintf(&b, "  [DENY]  %s\n", msg)
+	}
+	for _, msg := range r.Advisory {
+		fmt.Fprintf(&b, "  [WARN]  %s\n", msg)
+	}
+	return b.String()
+}
+
+// loadPolicySeverity reads tests/policies/config.yaml and returns the set
+// of Rego package names that are advisory-only for the given environment.
+func loadPolicySeverity(policyDir, environment string) (map[string]bool, error) {
+	raw, err := os.ReadFile(filepath.Join(policyDir, "config.yaml"))
+	if err != nil {
+		return nil, fmt.Errorf("reading policy config: %w", err)
+	}
+
+	var cfg policyConfig
+	if err := yaml.Unmarshal(raw, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing policy config: %w", err)
+	}
+
+	advisory := map[string]bool{}
+	if env, ok := cfg[environment]; ok {
+		for _, name := range env.Advisory {
+			advisory[name] = true
+		}
+	}
+	return advisory, nil
+}
+
+// runConftest shells out to `conftest test` against planJSONPath using the
+// Rego bundles in policyDir, then reclassifies denies as warnings for any
+// policy marked advisory for environment.
+func runConftest(planJSONPath, policyDir, environment string) (*PolicyReport, error) {
+	advisory, err := loadPolicySeverity(policyDir, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command("conftest", "test", "-o", "json", "--all-namespaces", "--policy", policyDir, planJSONPath)
+	out, _ := cmd.Output() // conftest exits non-zero on any failure; inspect output instead
+
+	var results []policyResult
+	if err := json.Unmarshal(out, &results); err != nil {
+		return nil, fmt.Errorf("parsing conftest output: %w: %s", err, string(out))
+	}
+
+	report := &PolicyReport{Environment: environment}
+	for _, result := range results {
+		policyName := strings.TrimPrefix(result.Namespace, "policy.")
+		for _, warning := range result.Warnings {
+			report.Advisory = append(report.Advisory, fmt.Sprintf("%s: %s", policyName, warning.Msg))
+		}
+		for _, failure := range result.Failures {
+			msg := fmt.Sprintf("%s: %s", policyName, failure.Msg)
+			if advisory[policyName] {
+				report.Advisory = append(report.Advisory, msg)
+			} else {
+				report.Blocking = append(report.Blocking, msg)
+			}
+		}
+	}
+	return report, nil
+}
+
+// writePlanJSON writes a terraform show -json payload to path, failing the
+// test immediately if the write fails.
+func writePlanJSON(t *testing.T, path, planJSON string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(planJSON), 0o644); err != nil {
+		t.Fatalf("writing plan JSON to %s: %v", path, err)
+	}
+}
@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTerraformPolicyCompliance runs `terraform plan` for each module,
+// converts the plan to JSON, and evaluates it against the Rego bundles in
+// tests/policies/ via conftest. Warn rules never fail the test, and deny
+// rules of policies flagged as advisory for the module's environment in
+// tests/policies/config.yaml only warn; any other deny fails the test.
+func TestTerraformPolicyCompliance(t *testing.T) {
+	t.Parallel()
+
+	policyDir, err := filepath.Abs("policies")
+	require.NoError(t, err)
+
+	modules := []struct {
+		dir         string
+		environment string
+	}{
+		{"../modules/ibm-cloud/managed-services", "dev"},
+		{"../modules/ibm-cloud/code-engine", "dev"},
+		{"../environments/ibm", "dev"},
+		{"../environments/ibm", "production"},
+	}
+
+	for _, module := range modules {
+		module := module
+		t.Run(filepath.Base(module.dir)+"_"+module.environment, func(t *testing.T) {
+			t.Parallel()
+
+			terraformOptions := &terraform.Options{
+				TerraformDir: module.dir,
+				Vars:         policyComplianceVars(module.dir, module.environment),
+				PlanFilePath: filepath.Join(t.TempDir(), "plan.tfplan"),
+			}
+
+			terraform.InitAndPlan(t, terraformOptions)
+
+			planJSONPath := filepath.Join(t.TempDir(), "plan.json")
+			planJSON := terraform.Show(t, terraformOptions)
+			writePlanJSON(t, planJSONPath, planJSON)
+
+			report, err := runConftest(planJSONPath, policyDir, module.environment)
+			require.NoError(t, err)
+
+			t.Log(report.String())
+			require.Falsef(t, report.Failed(), "policy compliance failed:\n%s", report.String())
+		})
+	}
+}
+
+// policyComplianceVars returns the Vars needed to plan dir, mirroring the
+// var maps terraform_plan_test.go and cost_test.go use for the same
+// modules so InitAndPlan doesn't fail on a missing required variable before
+// any policy has a chance to run.
+func policyComplianceVars(dir, environment string) map[string]interface{} {
+	switch filepath.Base(dir) {
+	case "managed-services":
+		return map[string]interface{}{
+			"project_name":              "test-policy",
+			"environment":               environment,
+			"region":                    "us-south",
+			"resource_group_id":         "test-resource-group",
+			"postgresql_admin_password": "test-password-123",
+		}
+	case "code-engine":
+		return map[string]interface{}{
+			"project_name":                "test-policy",
+			"environment":                 environment,
+			"resource_group_id":           "test-resource-group",
+			"container_registry_url":      "us.icr.io",
+			"container_registry_username": "iamapikey",
+			"container_registry_password": "test-password",
+			"backend_image_tag":           "v1.0.0",
+			"frontend_image_tag":          "v1.0.0",
+			"postgresql_host":             "test-postgres.example.com",
+			"postgresql_port":             5432,
+			"postgresql_database":         "test_db",
+			"postgresql_username":         "test_user",
+			"postgresql_password":         "test_password",
+			"postgresql_instance_id":      "test-postgres-instance",
+			"object_storage_endpoint":     "test-storage.example.com",
+			"object_storage_access_key":   "test_access_key",
+			"object_storage_secret_key":   "test_secret_key",
+			"object_storage_bucket_name":  "test-bucket",
+			"object_storage_instance_id":  "test-storage-instance",
+			"zilliz_endpoint":             "test-zilliz.example.com",
+			"zilliz_api_key":              "test_zilliz_key",
+			"zilliz_instance_id":          "test-zilliz-instance",
+			"event_streams_endpoint":      "test-kafka.example.com",
+			"event_streams_api_key":       "test_kafka_key",
+			"event_streams_instance_id":   "test-kafka-instance",
+		}
+	default: // "ibm" (environments/ibm)
+		return map[string]interface{}{
+			"project_name":                "test-policy",
+			"environment":                 environment,
+			"region":                      "us-south",
+			"resource_group_name":         "test-resource-group",
+			"ibmcloud_api_key":            "test-api-key",
+			"container_registry_username": "iamapikey",
+			"container_registry_password": "test-password",
+			"postgresql_admin_password":   "test-password-123",
+		}
+	}
+}
@@ -0,0 +1,205 @@
+//go:build integration
+
+package tests
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/random"
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manavgup/rag_modulo/deployment/terraform/pkg/terratest/ibmcloud"
+)
+
+// These tests provision real IBM Cloud resources via terraform.InitAndApply
+// and require IBMCLOUD_API_KEY. Run with `go test -tags=integration ./...`.
+// For the offline equivalents that only run `terraform plan`, see
+// terraform_plan_test.go. The managed-services and Code Engine tests take
+// their Terraform options from newResilientOptions (terraform_resilience.go),
+// which retries the transient errors IBM Cloud provisioning is known to
+// produce; on failure, dumpFailureArtifacts saves diagnostic state for CI to
+// upload. TestTerraformEnvironmentConfiguration uses plain terraform.Options
+// with no retries and saves no artifacts.
+
+func TestTerraformManagedServicesModule(t *testing.T) {
+	t.Parallel()
+
+	randomName := strings.ToLower(random.UniqueId())
+	instanceName := "test-" + randomName
+
+	terraformOptions := newResilientOptions(
+		"../modules/ibm-cloud/managed-services",
+		map[string]interface{}{
+			"project_name":              instanceName,
+			"environment":               "dev",
+			"region":                    "us-south",
+			"resource_group_id":         "test-resource-group",
+			"postgresql_admin_password": "test-password-123",
+		},
+		map[string]string{
+			"TF_VAR_ibmcloud_api_key": os.Getenv("IBMCLOUD_API_KEY"),
+		},
+	)
+
+	defer func() {
+		dumpFailureArtifacts(t, terraformOptions, "")
+		terraform.Destroy(t, terraformOptions)
+
+		if apiKey := os.Getenv("IBMCLOUD_API_KEY"); apiKey != "" {
+			err := ibmcloud.VerifyResourcesDeleted(apiKey, "test-resource-group", []string{instanceName})
+			assert.NoError(t, err, "orphaned resources detected after destroy")
+		}
+	}()
+
+	withApplyTimeout(t, func() {
+		terraform.InitAndApply(t, terraformOptions)
+
+		postgresqlHost := terraform.Output(t, terraformOptions, "postgresql_host")
+		assert.NotEmpty(t, postgresqlHost, "PostgreSQL host should not be empty")
+
+		objectStorageEndpoint := terraform.Output(t, terraformOptions, "object_storage_endpoint")
+		assert.NotEmpty(t, objectStorageEndpoint, "Object Storage endpoint should not be empty")
+
+		zillizEndpoint := terraform.Output(t, terraformOptions, "zilliz_endpoint")
+		assert.NotEmpty(t, zillizEndpoint, "Zilliz endpoint should not be empty")
+
+		eventStreamsEndpoint := terraform.Output(t, terraformOptions, "event_streams_endpoint")
+		assert.NotEmpty(t, eventStreamsEndpoint, "Event Streams endpoint should not be empty")
+	})
+}
+
+func TestTerraformCodeEngineModule(t *testing.T) {
+	t.Parallel()
+
+	randomName := strings.ToLower(random.UniqueId())
+	codeEngineAppName := "test-" + randomName + "-backend"
+
+	terraformOptions := newResilientOptions(
+		"../modules/ibm-cloud/code-engine",
+		map[string]interface{}{
+			"project_name":                "test-" + randomName,
+			"environment":                 "dev",
+			"resource_group_id":           "test-resource-group",
+			"container_registry_url":      "us.icr.io",
+			"container_registry_username": "iamapikey",
+			"container_registry_password": "test-password",
+			"backend_image_tag":           "v1.0.0",
+			"frontend_image_tag":          "v1.0.0",
+			"postgresql_host":             "test-postgres.example.com",
+			"postgresql_port":             5432,
+			"postgresql_database":         "test_db",
+			"postgresql_username":         "test_user",
+			"postgresql_password":         "test_password",
+			"postgresql_instance_id":      "test-postgres-instance",
+			"object_storage_endpoint":     "test-storage.example.com",
+			"object_storage_access_key":   "test_access_key",
+			"object_storage_secret_key":   "test_secret_key",
+			"object_storage_bucket_name":  "test-bucket",
+			"object_storage_instance_id":  "test-storage-instance",
+			"zilliz_endpoint":             "test-zilliz.example.com",
+			"zilliz_api_key":              "test_zilliz_key",
+			"zilliz_instance_id":          "test-zilliz-instance",
+			"event_streams_endpoint":      "test-kafka.example.com",
+			"event_streams_api_key":       "test_kafka_key",
+			"event_streams_instance_id":   "test-kafka-instance",
+		},
+		map[string]string{
+			"TF_VAR_ibmcloud_api_key": os.Getenv("IBMCLOUD_API_KEY"),
+		},
+	)
+
+	defer func() {
+		dumpFailureArtifacts(t, terraformOptions, codeEngineAppName)
+		terraform.Destroy(t, terraformOptions)
+
+		if apiKey := os.Getenv("IBMCLOUD_API_KEY"); apiKey != "" {
+			err := ibmcloud.VerifyResourcesDeleted(apiKey, "test-resource-group", []string{codeEngineAppName})
+			assert.NoError(t, err, "orphaned resources detected after destroy")
+		}
+	}()
+
+	withApplyTimeout(t, func() {
+		terraform.InitAndApply(t, terraformOptions)
+
+		projectId := terraform.Output(t, terraformOptions, "project_id")
+		require.NotEmpty(t, projectId, "Project ID should not be empty")
+
+		backendEndpoint := terraform.Output(t, terraformOptions, "backend_endpoint")
+		assert.NotEmpty(t, backendEndpoint, "Backend endpoint should not be empty")
+
+		frontendEndpoint := terraform.Output(t, terraformOptions, "frontend_endpoint")
+		assert.NotEmpty(t, frontendEndpoint, "Frontend endpoint should not be empty")
+
+		backendHealthEndpoint := terraform.Output(t, terraformOptions, "backend_health_endpoint")
+		assert.Contains(t, backendHealthEndpoint, "/health", "Backend health endpoint should contain /health")
+	})
+}
+
+func TestTerraformEnvironmentConfiguration(t *testing.T) {
+	t.Parallel()
+
+	// Test development environment
+	t.Run("DevelopmentEnvironment", func(t *testing.T) {
+		terraformOptions := &terraform.Options{
+			TerraformDir: "../environments/ibm",
+			Vars: map[string]interface{}{
+				"project_name":                "test-dev",
+				"environment":                 "dev",
+				"region":                      "us-south",
+				"resource_group_name":         "test-resource-group",
+				"ibmcloud_api_key":            "test-api-key",
+				"container_registry_username": "iamapikey",
+				"container_registry_password": "test-password",
+				"postgresql_admin_password":   "test-password-123",
+			},
+		}
+
+		// Clean up after test
+		defer terraform.Destroy(t, terraformOptions)
+
+		// Initialize and apply
+		terraform.InitAndApply(t, terraformOptions)
+
+		// Test outputs
+		projectName := terraform.Output(t, terraformOptions, "project_name")
+		assert.Equal(t, "test-dev", projectName, "Project name should match")
+
+		environment := terraform.Output(t, terraformOptions, "environment")
+		assert.Equal(t, "dev", environment, "Environment should be dev")
+	})
+
+	// Test production environment
+	t.Run("ProductionEnvironment", func(t *testing.T) {
+		terraformOptions := &terraform.Options{
+			TerraformDir: "../environments/ibm",
+			Vars: map[string]interface{}{
+				"project_name":                 "test-prod",
+				"environment":                  "production",
+				"region":                       "us-south",
+				"resource_group_name":          "test-resource-group",
+				"ibmcloud_api_key":             "test-api-key",
+				"container_registry_username":  "iamapikey",
+				"container_registry_password":  "test-password",
+				"postgresql_admin_password":    "test-password-123",
+				"enable_production_safeguards": true,
+			},
+		}
+
+		// Clean up after test
+		defer terraform.Destroy(t, terraformOptions)
+
+		// Initialize and apply
+		terraform.InitAndApply(t, terraformOptions)
+
+		// Test outputs
+		projectName := terraform.Output(t, terraformOptions, "project_name")
+		assert.Equal(t, "test-prod", projectName, "Project name should match")
+
+		environment := terraform.Output(t, terraformOptions, "environment")
+		assert.Equal(t, "production", environment, "Environment should be production")
+	})
+}
@@ -0,0 +1,154 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/random"
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Offline counterparts to the integration-tagged apply tests in
+// terraform_integration_test.go. These only ever run `terraform plan`, so
+// they need no IBMCLOUD_API_KEY and provision nothing; credentials are
+// stubbed (via TF_VAR_ibmcloud_api_key for the modules, via the
+// ibmcloud_api_key variable for environments/ibm) so the provider can still
+// plan.
+
+func TestTerraformManagedServicesModule_Plan(t *testing.T) {
+	t.Parallel()
+
+	randomName := strings.ToLower(random.UniqueId())
+
+	terraformOptions := &terraform.Options{
+		TerraformDir: "../modules/ibm-cloud/managed-services",
+		Vars: map[string]interface{}{
+			"project_name":              "test-" + randomName,
+			"environment":               "dev",
+			"region":                    "us-south",
+			"resource_group_id":         "test-resource-group",
+			"postgresql_admin_password": "test-password-123",
+		},
+		EnvVars: map[string]string{
+			"TF_VAR_ibmcloud_api_key": "stub-api-key",
+		},
+	}
+
+	plan := terraform.InitAndPlanAndShowWithStruct(t, terraformOptions)
+	require.NotNil(t, plan)
+
+	assert.NotEmpty(t, plan.ResourceChangesMap, "plan should include resource changes")
+	assert.NotEmpty(t, plan.RawPlan.PlannedValues.RootModule.Resources, "planned values should include root module resources")
+}
+
+func TestTerraformCodeEngineModule_Plan(t *testing.T) {
+	t.Parallel()
+
+	randomName := strings.ToLower(random.UniqueId())
+
+	terraformOptions := &terraform.Options{
+		TerraformDir: "../modules/ibm-cloud/code-engine",
+		Vars: map[string]interface{}{
+			"project_name":                "test-" + randomName,
+			"environment":                 "dev",
+			"resource_group_id":           "test-resource-group",
+			"container_registry_url":      "us.icr.io",
+			"container_registry_username": "iamapikey",
+			"container_registry_password": "test-password",
+			"backend_image_tag":           "v1.0.0",
+			"frontend_image_tag":          "v1.0.0",
+			"postgresql_host":             "test-postgres.example.com",
+			"postgresql_port":             5432,
+			"postgresql_database":         "test_db",
+			"postgresql_username":         "test_user",
+			"postgresql_password":         "test_password",
+			"postgresql_instance_id":      "test-postgres-instance",
+			"object_storage_endpoint":     "test-storage.example.com",
+			"object_storage_access_key":   "test_access_key",
+			"object_storage_secret_key":   "test_secret_key",
+			"object_storage_bucket_name":  "test-bucket",
+			"object_storage_instance_id":  "test-storage-instance",
+			"zilliz_endpoint":             "test-zilliz.example.com",
+			"zilliz_api_key":              "test_zilliz_key",
+			"zilliz_instance_id":          "test-zilliz-instance",
+			"event_streams_endpoint":      "test-kafka.example.com",
+			"event_streams_api_key":       "test_kafka_key",
+			"event_streams_instance_id":   "test-kafka-instance",
+		},
+		EnvVars: map[string]string{
+			"TF_VAR_ibmcloud_api_key": "stub-api-key",
+		},
+	}
+
+	plan := terraform.InitAndPlanAndShowWithStruct(t, terraformOptions)
+	require.NotNil(t, plan)
+
+	outputs := plan.RawPlan.PlannedValues.Outputs
+	require.NotNil(t, outputs["backend_health_endpoint"])
+	healthEndpoint, ok := outputs["backend_health_endpoint"].Value.(string)
+	require.True(t, ok, "backend_health_endpoint output should be a string")
+	assert.Contains(t, healthEndpoint, "/health", "planned backend health endpoint should contain /health")
+
+	require.NotNil(t, outputs["project_id"])
+
+	assert.NotEmpty(t, plan.RawPlan.ResourceChanges, "plan should include resource changes for managed-services wiring")
+}
+
+func TestTerraformEnvironmentConfiguration_Plan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("DevelopmentEnvironment", func(t *testing.T) {
+		t.Parallel()
+
+		terraformOptions := &terraform.Options{
+			TerraformDir: "../environments/ibm",
+			Vars: map[string]interface{}{
+				"project_name":                "test-dev",
+				"environment":                 "dev",
+				"region":                      "us-south",
+				"resource_group_name":         "test-resource-group",
+				"ibmcloud_api_key":            "test-api-key",
+				"container_registry_username": "iamapikey",
+				"container_registry_password": "test-password",
+				"postgresql_admin_password":   "test-password-123",
+			},
+		}
+
+		plan := terraform.InitAndPlanAndShowWithStruct(t, terraformOptions)
+		require.NotNil(t, plan)
+
+		outputs := plan.RawPlan.PlannedValues.Outputs
+		require.NotNil(t, outputs["project_name"])
+		require.NotNil(t, outputs["environment"])
+		assert.Equal(t, "dev", outputs["environment"].Value, "planned environment output should be dev")
+	})
+
+	t.Run("ProductionEnvironment", func(t *testing.T) {
+		t.Parallel()
+
+		terraformOptions := &terraform.Options{
+			TerraformDir: "../environments/ibm",
+			Vars: map[string]interface{}{
+				"project_name":                 "test-prod",
+				"environment":                  "production",
+				"region":                       "us-south",
+				"resource_group_name":          "test-resource-group",
+				"ibmcloud_api_key":             "test-api-key",
+				"container_registry_username":  "iamapikey",
+				"container_registry_password":  "test-password",
+				"postgresql_admin_password":    "test-password-123",
+				"enable_production_safeguards": true,
+			},
+		}
+
+		plan := terraform.InitAndPlanAndShowWithStruct(t, terraformOptions)
+		require.NotNil(t, plan)
+
+		outputs := plan.RawPlan.PlannedValues.Outputs
+		require.NotNil(t, outputs["project_name"])
+		require.NotNil(t, outputs["environment"])
+		assert.Equal(t, "production", outputs["environment"].Value, "planned environment output should be production")
+	})
+}
@@ -0,0 +1,121 @@
+//go:build integration
+
+package tests
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+)
+
+// retryableTerraformErrors are transient failure patterns IBM Cloud
+// provisioning (databases, Event Streams, Code Engine) is known to produce.
+// Terratest retries the apply/destroy when the error output matches one of
+// these.
+var retryableTerraformErrors = map[string]string{
+	".*timeout while waiting for state.*":   "IBM Cloud resource took longer than expected to reach its target state",
+	".*Error 500.*":                         "IBM Cloud API returned a transient server error",
+	".*connection reset.*":                  "network blip talking to the IBM Cloud API",
+	".*RequestError: send request failed.*": "transient network failure",
+}
+
+const (
+	defaultMaxRetries         = 3
+	defaultTimeBetweenRetries = 30 * time.Second
+	defaultApplyTimeout       = 30 * time.Minute
+)
+
+// newResilientOptions builds terraform.Options shared by the live
+// IBM Cloud apply tests, pre-configured with retry behavior for the
+// transient errors this provider is known to produce.
+func newResilientOptions(dir string, vars map[string]interface{}, envVars map[string]string) *terraform.Options {
+	return &terraform.Options{
+		TerraformDir:             dir,
+		Vars:                     vars,
+		EnvVars:                  envVars,
+		RetryableTerraformErrors: retryableTerraformErrors,
+		MaxRetries:               defaultMaxRetries,
+		TimeBetweenRetries:       defaultTimeBetweenRetries,
+	}
+}
+
+// dumpFailureArtifacts captures diagnostic state into a new temporary
+// directory when a live IBM Cloud test fails, so CI can upload it as a build
+// artifact: the plan/state as JSON, the resource group's service instances,
+// and (if a Code Engine app name is known) its recent logs. The directory
+// comes from os.MkdirTemp rather than t.TempDir(), which the testing package
+// deletes as soon as the test ends.
+func dumpFailureArtifacts(t *testing.T, options *terraform.Options, codeEngineAppName string) {
+	t.Helper()
+	if !t.Failed() {
+		return
+	}
+
+	dir, err := os.MkdirTemp("", "terratest-failure-artifacts-")
+	if err != nil {
+		t.Logf("creating failure artifact directory: %v", err)
+		return
+	}
+
+	if showJSON, err := exec.Command("terraform", "-chdir="+options.TerraformDir, "show", "-json").Output(); err == nil {
+		path := filepath.Join(dir, "terraform-show.json")
+		if err := os.WriteFile(path, showJSON, 0o644); err == nil {
+			t.Logf("wrote terraform show -json to %s", path)
+		}
+	}
+
+	if instances, err := exec.Command("ibmcloud", "resource", "service-instances", "--output", "json").Output(); err == nil {
+		path := filepath.Join(dir, "service-instances.json")
+		if err := os.WriteFile(path, instances, 0o644); err == nil {
+			t.Logf("wrote ibmcloud resource service-instances to %s", path)
+		}
+	}
+
+	if codeEngineAppName != "" {
+		if logs, err := exec.Command("ibmcloud", "ce", "app", "logs", "--name", codeEngineAppName).Output(); err == nil {
+			path := filepath.Join(dir, "code-engine-app-logs.txt")
+			if err := os.WriteFile(path, logs, 0o644); err == nil {
+				t.Logf("wrote Code Engine app logs to %s", path)
+			}
+		}
+	}
+}
+
+// withApplyTimeout fails the test if fn (an apply-and-assert block) hasn't
+// returned within defaultApplyTimeout, guarding against IBM Cloud
+// provisioning hanging indefinitely instead of erroring out.
+//
+// fn always runs to completion before withApplyTimeout returns, even past
+// the timeout: the caller's deferred terraform.Destroy/VerifyResourcesDeleted
+// must not start while InitAndApply is still in flight, or the destroy races
+// the apply against the same state and working directory — exactly the
+// orphaned-resource failure mode this harness exists to catch. A panic
+// inside fn (an SDK bug, for example) is recovered and reported as a test
+// failure instead of crashing the whole test binary. A failed require.*
+// inside fn is not a panic: it marks the test failed and ends fn's goroutine
+// via runtime.Goexit, and withApplyTimeout then returns normally.
+func withApplyTimeout(t *testing.T, fn func()) {
+	t.Helper()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			if r := recover(); r != nil {
+				t.Errorf("panic during apply: %v", r)
+			}
+		}()
+		fn()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(defaultApplyTimeout):
+		t.Errorf("test exceeded wall-clock timeout of %s; waiting for the in-flight apply to finish before cleanup", defaultApplyTimeout)
+		<-done
+	}
+}